@@ -22,14 +22,17 @@ const (
 	image         = "amazon/dynamodb-local:2.2.1"
 	port          = nat.Port("8000/tcp")
 	containerName = "dynamodb_local"
+
+	// dataVolumeTargetPath is where DynamoDB Local is told (via -dbPath) to
+	// write its database files inside the container, so that a host mount at
+	// this path persists data across container removal.
+	dataVolumeTargetPath = "/home/dynamodblocal/data"
 )
 
 // RunContainer creates an instance of the dynamodb container type
 func RunContainer(ctx context.Context, opts ...testcontainers.ContainerCustomizer) (*DynamodbLocalContainer, error) {
 	req := testcontainers.ContainerRequest{
-		Image:        image,
-		ExposedPorts: []string{string(port)},
-		WaitingFor:   wait.ForListeningPort(port),
+		Image: image,
 	}
 
 	genericContainerReq := testcontainers.GenericContainerRequest{
@@ -41,6 +44,16 @@ func RunContainer(ctx context.Context, opts ...testcontainers.ContainerCustomize
 		opt.Customize(&genericContainerReq)
 	}
 
+	if err := buildCmd(&genericContainerReq); err != nil {
+		return nil, err
+	}
+
+	// WithPort may have changed the port DynamoDB Local listens on; expose
+	// and wait on whatever was actually requested instead of the default.
+	containerPort := portFromCmd(genericContainerReq.Cmd)
+	genericContainerReq.ExposedPorts = []string{string(containerPort)}
+	genericContainerReq.WaitingFor = wait.ForListeningPort(containerPort)
+
 	//log.Println("CMD", genericContainerReq.Cmd)
 
 	container, err := testcontainers.GenericContainer(ctx, genericContainerReq)
@@ -48,12 +61,27 @@ func RunContainer(ctx context.Context, opts ...testcontainers.ContainerCustomize
 		return nil, err
 	}
 
-	return &DynamodbLocalContainer{Container: container}, nil
+	c := &DynamodbLocalContainer{Container: container}
+
+	if err := applyStartupFixtures(ctx, c, genericContainerReq.Labels); err != nil {
+		return nil, err
+	}
+
+	return c, nil
 }
 
 // ConnectionString returns DynamoDB local endpoint host and port in <host>:<port> format
 func (c *DynamodbLocalContainer) ConnectionString(ctx context.Context) (string, error) {
-	mappedPort, err := c.MappedPort(ctx, port)
+	return connectionString(ctx, c.Container)
+}
+
+func connectionString(ctx context.Context, c testcontainers.Container) (string, error) {
+	p, err := resolvePort(ctx, c)
+	if err != nil {
+		return "", err
+	}
+
+	mappedPort, err := c.MappedPort(ctx, p)
 	if err != nil {
 		return "", err
 	}
@@ -67,13 +95,36 @@ func (c *DynamodbLocalContainer) ConnectionString(ctx context.Context) (string,
 	return uri, nil
 }
 
-func (c *DynamodbLocalContainer) GetDynamoDBClient(ctx context.Context) (*dynamodb.Client, error) {
-	hostAndPort, err := c.ConnectionString(context.Background())
+// resolvePort returns the container's single exposed port. DynamoDB Local
+// only ever exposes one (8000 by default, or whatever WithPort requested),
+// so callers don't need to know which one up front.
+func resolvePort(ctx context.Context, c testcontainers.Container) (nat.Port, error) {
+	ports, err := c.Ports(ctx)
+	if err != nil {
+		return "", fmt.Errorf("dynamodblocal: failed to inspect container ports: %w", err)
+	}
+
+	for p := range ports {
+		return p, nil
+	}
+
+	return "", fmt.Errorf("dynamodblocal: container exposes no ports")
+}
+
+// GetDynamoDBClient returns a dynamodb.Client pointed at this container.
+// optFns are applied after the endpoint resolver, so callers can further
+// customize the client - e.g. WithRequestLogging.
+func (c *DynamodbLocalContainer) GetDynamoDBClient(ctx context.Context, optFns ...func(*dynamodb.Options)) (*dynamodb.Client, error) {
+	return dynamoDBClient(context.Background(), c.Container, optFns...)
+}
+
+func dynamoDBClient(ctx context.Context, c testcontainers.Container, optFns ...func(*dynamodb.Options)) (*dynamodb.Client, error) {
+	hostAndPort, err := connectionString(ctx, c)
 	if err != nil {
 		return nil, err
 	}
 
-	cfg, err := config.LoadDefaultConfig(context.Background(), config.WithCredentialsProvider(credentials.StaticCredentialsProvider{
+	cfg, err := config.LoadDefaultConfig(ctx, config.WithCredentialsProvider(credentials.StaticCredentialsProvider{
 		Value: aws.Credentials{
 			AccessKeyID:     "DUMMYIDEXAMPLE",
 			SecretAccessKey: "DUMMYEXAMPLEKEY",
@@ -83,32 +134,9 @@ func (c *DynamodbLocalContainer) GetDynamoDBClient(ctx context.Context) (*dynamo
 		return nil, err
 	}
 
-	return dynamodb.NewFromConfig(cfg, dynamodb.WithEndpointResolverV2(&DynamoDBLocalResolver{hostAndPort: hostAndPort})), nil
-}
+	opts := append([]func(*dynamodb.Options){
+		dynamodb.WithEndpointResolverV2(&DynamoDBLocalResolver{hostAndPort: hostAndPort}),
+	}, optFns...)
 
-// WithSharedDB allows container reuse between successive runs. Data will be persisted
-func WithSharedDB() testcontainers.CustomizeRequestOption {
-
-	return func(req *testcontainers.GenericContainerRequest) {
-		if len(req.Cmd) > 0 {
-			req.Cmd = append(req.Cmd, "-sharedDb")
-		} else {
-			req.Cmd = append(req.Cmd, "-jar", "DynamoDBLocal.jar", "-sharedDb")
-		}
-		req.Name = containerName
-		req.Reuse = true
-	}
-}
-
-// WithTelemetryDisabled - DynamoDB local will not send any telemetry
-func WithTelemetryDisabled() testcontainers.CustomizeRequestOption {
-
-	return func(req *testcontainers.GenericContainerRequest) {
-		// if other flags (e.g. -sharedDb) exist, append to them
-		if len(req.Cmd) > 0 {
-			req.Cmd = append(req.Cmd, "-disableTelemetry")
-		} else {
-			req.Cmd = append(req.Cmd, "-jar", "DynamoDBLocal.jar", "-disableTelemetry")
-		}
-	}
+	return dynamodb.NewFromConfig(cfg, opts...), nil
 }
@@ -2,12 +2,14 @@ package dynamodblocal
 
 import (
 	"context"
+	"os"
 	"testing"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
 	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/docker/go-connections/nat"
 	"github.com/stretchr/testify/require"
 )
 
@@ -175,6 +177,33 @@ func TestIntegrationWithoutSharedDB(t *testing.T) {
 	require.Empty(t, result.TableNames, "table should not exist after restarting container")
 }
 
+func TestContainerShouldStartWithDataVolume(t *testing.T) {
+	ctx := context.Background()
+
+	dataDir := t.TempDir()
+
+	container, err := RunContainer(ctx, WithDataVolume(dataDir))
+	require.NoError(t, err)
+
+	// Clean up the container after the test is complete
+	t.Cleanup(func() {
+		err := container.Terminate(context.Background())
+		if err != nil {
+			t.Fatalf("container termination failed: %s", err)
+		}
+	})
+
+	client, err := container.GetDynamoDBClient(context.Background())
+	require.NoError(t, err, "failed to get dynamodb client handle")
+
+	err = createTable(client)
+	require.NoError(t, err, "dynamodb create table operation failed")
+
+	entries, err := os.ReadDir(dataDir)
+	require.NoError(t, err, "host data directory should be readable")
+	require.NotEmpty(t, entries, "dynamodb local should have persisted files to the mounted host directory")
+}
+
 func TestContainerShouldStartWithTelemetryDisabled(t *testing.T) {
 	ctx := context.Background()
 
@@ -205,6 +234,171 @@ func TestContainerShouldStartWithSharedDBEnabledAndTelemetryDisabled(t *testing.
 	})
 }
 
+func TestContainerShouldStartWithInMemoryCORSAndDelayTransientStatuses(t *testing.T) {
+	ctx := context.Background()
+
+	container, err := RunContainer(ctx, WithInMemory(), WithCORS("*"), WithDelayTransientStatuses())
+	require.NoError(t, err)
+
+	// Clean up the container after the test is complete
+	t.Cleanup(func() {
+		err := container.Terminate(context.Background())
+		if err != nil {
+			t.Fatalf("container termination failed: %s", err)
+		}
+	})
+}
+
+func TestContainerShouldStartWithCustomPort(t *testing.T) {
+	ctx := context.Background()
+
+	const customPort = 9999
+
+	container, err := RunContainer(ctx, WithPort(customPort))
+	require.NoError(t, err)
+
+	// Clean up the container after the test is complete
+	t.Cleanup(func() {
+		err := container.Terminate(context.Background())
+		if err != nil {
+			t.Fatalf("container termination failed: %s", err)
+		}
+	})
+
+	mappedPort, err := container.MappedPort(context.Background(), nat.Port("9999/tcp"))
+	require.NoError(t, err, "container should expose the custom port requested via WithPort")
+	require.NotEmpty(t, mappedPort.Port())
+
+	client, err := container.GetDynamoDBClient(context.Background())
+	require.NoError(t, err, "failed to get dynamodb client handle")
+
+	_, err = client.ListTables(context.Background(), nil)
+	require.NoError(t, err, "dynamodb list tables operation should succeed against the custom port")
+}
+
+func TestRunContainerShouldFailWithInMemoryAndDataVolume(t *testing.T) {
+	ctx := context.Background()
+
+	container, err := RunContainer(ctx, WithInMemory(), WithDataVolume(t.TempDir()))
+	require.Error(t, err, "WithInMemory and WithDataVolume are mutually exclusive")
+	require.Nil(t, container)
+}
+
+func TestContainerShouldStartWithSchemaAndSeedData(t *testing.T) {
+	ctx := context.Background()
+
+	container, err := RunContainer(ctx, WithSchema("testdata/schema.json"), WithSeedData("testdata/seed.json"))
+	require.NoError(t, err)
+
+	// Clean up the container after the test is complete
+	t.Cleanup(func() {
+		err := container.Terminate(context.Background())
+		if err != nil {
+			t.Fatalf("container termination failed: %s", err)
+		}
+	})
+
+	client, err := container.GetDynamoDBClient(context.Background())
+	require.NoError(t, err, "failed to get dynamodb client handle")
+
+	result, err := client.ListTables(context.Background(), nil)
+	require.NoError(t, err, "dynamodb list tables operation failed")
+	require.Equal(t, tableName, result.TableNames[0])
+
+	queryResult, err := queryItem(client, "seed_value_1")
+	require.NoError(t, err, "seeded data should be queryable")
+	require.Equal(t, "seed_value_1", queryResult)
+}
+
+func TestContainerShouldStartWithSchemaAndSharedDBAcrossRuns(t *testing.T) {
+	ctx := context.Background()
+
+	container, err := RunContainer(ctx, WithSharedDB(), WithSchema("testdata/schema.json"))
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		err := container.Terminate(context.Background())
+		if err != nil {
+			t.Fatalf("container termination failed: %s", err)
+		}
+	})
+
+	// A second RunContainer call against the same shared container must not
+	// fail just because WithSchema's table already exists from the first run.
+	container, err = RunContainer(ctx, WithSharedDB(), WithSchema("testdata/schema.json"))
+	require.NoError(t, err, "RunContainer should tolerate re-creating an existing table on a reused container")
+
+	client, err := container.GetDynamoDBClient(context.Background())
+	require.NoError(t, err, "failed to get dynamodb client handle")
+
+	result, err := client.ListTables(context.Background(), nil)
+	require.NoError(t, err, "dynamodb list tables operation failed")
+	require.Equal(t, tableName, result.TableNames[0])
+}
+
+func TestContainerShouldStartWithSeedDataPassedBeforeSchema(t *testing.T) {
+	ctx := context.Background()
+
+	// WithSeedData is passed before WithSchema here - the table must still
+	// be created before its seed items are written.
+	container, err := RunContainer(ctx, WithSeedData("testdata/seed.json"), WithSchema("testdata/schema.json"))
+	require.NoError(t, err)
+
+	// Clean up the container after the test is complete
+	t.Cleanup(func() {
+		err := container.Terminate(context.Background())
+		if err != nil {
+			t.Fatalf("container termination failed: %s", err)
+		}
+	})
+
+	client, err := container.GetDynamoDBClient(context.Background())
+	require.NoError(t, err, "failed to get dynamodb client handle")
+
+	queryResult, err := queryItem(client, "seed_value_1")
+	require.NoError(t, err, "seeded data should be queryable regardless of option order")
+	require.Equal(t, "seed_value_1", queryResult)
+}
+
+func TestApplyFixturesSnapshotAndRestore(t *testing.T) {
+	ctx := context.Background()
+
+	container, err := RunContainer(ctx)
+	require.NoError(t, err)
+
+	// Clean up the container after the test is complete
+	t.Cleanup(func() {
+		err := container.Terminate(context.Background())
+		if err != nil {
+			t.Fatalf("container termination failed: %s", err)
+		}
+	})
+
+	err = container.ApplyFixtures(ctx, "testdata/fixtures.json")
+	require.NoError(t, err, "fixtures should be applied")
+
+	client, err := container.GetDynamoDBClient(ctx)
+	require.NoError(t, err, "failed to get dynamodb client handle")
+
+	queryResult, err := queryItem(client, "fixture_value_1")
+	require.NoError(t, err, "fixture data should be queryable")
+	require.Equal(t, "fixture_value_1", queryResult)
+
+	snapshotPath := t.TempDir() + "/snapshot.json"
+	err = container.Snapshot(ctx, snapshotPath)
+	require.NoError(t, err, "snapshot should succeed")
+
+	err = addDataToTable(client, "added_after_snapshot")
+	require.NoError(t, err)
+
+	err = container.Restore(ctx, snapshotPath)
+	require.NoError(t, err, "restore should succeed")
+
+	queryResult, err = queryItem(client, "fixture_value_1")
+	require.NoError(t, err)
+	require.Equal(t, "fixture_value_1", queryResult, "restored snapshot should still contain the original item")
+}
+
 func createTable(client *dynamodb.Client) error {
 	_, err := client.CreateTable(context.Background(), &dynamodb.CreateTableInput{
 		TableName: aws.String(tableName),
@@ -0,0 +1,304 @@
+package dynamodblocal
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/testcontainers/testcontainers-go"
+)
+
+// seedTable is a single entry of a WithSeedData/Snapshot fixtures file: a
+// table name and the items to write to it, decoded via attributevalue into
+// DynamoDB's AttributeValue representation before being sent with
+// BatchWriteItem.
+type seedTable struct {
+	Table string                   `json:"table"`
+	Items []map[string]interface{} `json:"items"`
+}
+
+// fixtures is the shape ApplyFixtures reads: a file combining table
+// definitions (the WithSchema shape) and seed data (the WithSeedData shape).
+type fixtures struct {
+	Schema []dynamodb.CreateTableInput `json:"schema"`
+	Seed   []seedTable                 `json:"seed"`
+}
+
+// Labels used to pass the WithSchema/WithSeedData file paths through to
+// applyStartupFixtures, which runs once RunContainer's container is ready.
+// A label (rather than each option scheduling its own lifecycle hook) is
+// what lets applyStartupFixtures always create tables before seeding them,
+// regardless of the order WithSchema/WithSeedData were passed in.
+const (
+	labelSchemaPath = "dynamodblocal.schema-path"
+	labelSeedPath   = "dynamodblocal.seed-path"
+)
+
+// WithSchema loads a JSON file containing a list of dynamodb.CreateTableInput
+// - the same shape "aws dynamodb create-table --generate-cli-skeleton"
+// produces - and creates each table once the container is ready.
+func WithSchema(path string) testcontainers.CustomizeRequestOption {
+	return func(req *testcontainers.GenericContainerRequest) {
+		setLabel(req, labelSchemaPath, path)
+	}
+}
+
+// WithSeedData loads a JSON file containing a list of {table, items} entries
+// and writes the items to their table, via BatchWriteItem, once the
+// container is ready. Tables from WithSchema (if also passed) are always
+// created first, regardless of option order.
+func WithSeedData(path string) testcontainers.CustomizeRequestOption {
+	return func(req *testcontainers.GenericContainerRequest) {
+		setLabel(req, labelSeedPath, path)
+	}
+}
+
+func setLabel(req *testcontainers.GenericContainerRequest, key, value string) {
+	if req.Labels == nil {
+		req.Labels = map[string]string{}
+	}
+	req.Labels[key] = value
+}
+
+// applyStartupFixtures is called by RunContainer once its container is
+// ready. It creates the tables from WithSchema, if any, and then writes the
+// items from WithSeedData, if any - in that order, mirroring ApplyFixtures,
+// regardless of which option was passed to RunContainer first. createTables
+// tolerates tables that already exist, so this is safe to re-run against a
+// container reused via WithSharedDB.
+func applyStartupFixtures(ctx context.Context, c *DynamodbLocalContainer, labels map[string]string) error {
+	schemaPath := labels[labelSchemaPath]
+	seedPath := labels[labelSeedPath]
+
+	if schemaPath == "" && seedPath == "" {
+		return nil
+	}
+
+	client, err := c.GetDynamoDBClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	if schemaPath != "" {
+		var tables []dynamodb.CreateTableInput
+		if err := readJSONFile(schemaPath, &tables); err != nil {
+			return fmt.Errorf("dynamodblocal: failed to read schema %q: %w", schemaPath, err)
+		}
+		if err := createTables(ctx, client, tables); err != nil {
+			return err
+		}
+	}
+
+	if seedPath != "" {
+		var tables []seedTable
+		if err := readJSONFile(seedPath, &tables); err != nil {
+			return fmt.Errorf("dynamodblocal: failed to read seed data %q: %w", seedPath, err)
+		}
+		if err := seedTables(ctx, client, tables); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ApplyFixtures reads a fixtures file combining table definitions ("schema")
+// and seed data ("seed"), and applies it through the same client
+// GetDynamoDBClient returns - creating each table and then writing its seed
+// items. Unlike WithSchema/WithSeedData, it runs on demand rather than at
+// container start, so it can also be used to reseed an already-running
+// container (e.g. one started with WithSharedDB).
+func (c *DynamodbLocalContainer) ApplyFixtures(ctx context.Context, path string) error {
+	var f fixtures
+	if err := readJSONFile(path, &f); err != nil {
+		return fmt.Errorf("dynamodblocal: failed to read fixtures %q: %w", path, err)
+	}
+
+	client, err := c.GetDynamoDBClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := createTables(ctx, client, f.Schema); err != nil {
+		return err
+	}
+
+	return seedTables(ctx, client, f.Seed)
+}
+
+// Snapshot scans every table in the container and writes their items to
+// path, in the same shape WithSeedData/ApplyFixtures expect. Combined with
+// WithSharedDB, Restore can later reload the snapshot to support golden-data
+// test workflows.
+func (c *DynamodbLocalContainer) Snapshot(ctx context.Context, path string) error {
+	client, err := c.GetDynamoDBClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	var tableNames []string
+	paginator := dynamodb.NewListTablesPaginator(client, &dynamodb.ListTablesInput{})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return fmt.Errorf("dynamodblocal: failed to list tables: %w", err)
+		}
+		tableNames = append(tableNames, page.TableNames...)
+	}
+
+	snapshot := make([]seedTable, 0, len(tableNames))
+
+	for _, tableName := range tableNames {
+		table := seedTable{Table: tableName}
+
+		var exclusiveStartKey map[string]types.AttributeValue
+		for {
+			scanOutput, err := client.Scan(ctx, &dynamodb.ScanInput{
+				TableName:         &tableName,
+				ExclusiveStartKey: exclusiveStartKey,
+			})
+			if err != nil {
+				return fmt.Errorf("dynamodblocal: failed to scan table %q: %w", tableName, err)
+			}
+
+			for _, item := range scanOutput.Items {
+				var decoded map[string]interface{}
+				if err := attributevalue.UnmarshalMap(item, &decoded); err != nil {
+					return fmt.Errorf("dynamodblocal: failed to decode item from table %q: %w", tableName, err)
+				}
+				table.Items = append(table.Items, decoded)
+			}
+
+			exclusiveStartKey = scanOutput.LastEvaluatedKey
+			if len(exclusiveStartKey) == 0 {
+				break
+			}
+		}
+
+		snapshot = append(snapshot, table)
+	}
+
+	data, err := json.Marshal(snapshot)
+	if err != nil {
+		return fmt.Errorf("dynamodblocal: failed to marshal snapshot: %w", err)
+	}
+
+	return os.WriteFile(path, data, 0644)
+}
+
+// Restore replays a snapshot written by Snapshot (or a WithSeedData file)
+// back into the container's existing tables via BatchWriteItem.
+func (c *DynamodbLocalContainer) Restore(ctx context.Context, path string) error {
+	var tables []seedTable
+	if err := readJSONFile(path, &tables); err != nil {
+		return fmt.Errorf("dynamodblocal: failed to read snapshot %q: %w", path, err)
+	}
+
+	client, err := c.GetDynamoDBClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	return seedTables(ctx, client, tables)
+}
+
+func readJSONFile(path string, v interface{}) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(data, v)
+}
+
+// createTables creates each table, tolerating a ResourceInUseException for a
+// table that already exists - so applyStartupFixtures can run WithSchema
+// against a container reused via WithSharedDB without failing on the tables
+// it created the first time round.
+func createTables(ctx context.Context, client *dynamodb.Client, tables []dynamodb.CreateTableInput) error {
+	for i := range tables {
+		_, err := client.CreateTable(ctx, &tables[i])
+		if err == nil {
+			continue
+		}
+
+		var resourceInUse *types.ResourceInUseException
+		if errors.As(err, &resourceInUse) {
+			continue
+		}
+
+		return fmt.Errorf("dynamodblocal: failed to create table %q: %w", aws.ToString(tables[i].TableName), err)
+	}
+
+	return nil
+}
+
+func seedTables(ctx context.Context, client *dynamodb.Client, tables []seedTable) error {
+	const batchSize = 25 // BatchWriteItem accepts at most 25 items per call
+
+	for _, table := range tables {
+		for start := 0; start < len(table.Items); start += batchSize {
+			end := start + batchSize
+			if end > len(table.Items) {
+				end = len(table.Items)
+			}
+
+			writeRequests := make([]types.WriteRequest, 0, end-start)
+			for _, item := range table.Items[start:end] {
+				av, err := attributevalue.MarshalMap(item)
+				if err != nil {
+					return fmt.Errorf("dynamodblocal: failed to marshal item for table %q: %w", table.Table, err)
+				}
+
+				writeRequests = append(writeRequests, types.WriteRequest{
+					PutRequest: &types.PutRequest{Item: av},
+				})
+			}
+
+			if err := batchWriteWithRetry(ctx, client, table.Table, writeRequests); err != nil {
+				return fmt.Errorf("dynamodblocal: failed to seed table %q: %w", table.Table, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// batchWriteWithRetry calls BatchWriteItem and resubmits any
+// UnprocessedItems it reports, with a growing delay between attempts.
+// DynamoDB can partially fail a batch (e.g. due to throttling) without
+// returning an error, so a single call isn't enough to guarantee every item
+// was written.
+func batchWriteWithRetry(ctx context.Context, client *dynamodb.Client, table string, writeRequests []types.WriteRequest) error {
+	const maxAttempts = 8
+
+	requestItems := map[string][]types.WriteRequest{table: writeRequests}
+
+	for attempt := 0; attempt < maxAttempts && len(requestItems) > 0; attempt++ {
+		if attempt > 0 {
+			time.Sleep(time.Duration(attempt) * 50 * time.Millisecond)
+		}
+
+		output, err := client.BatchWriteItem(ctx, &dynamodb.BatchWriteItemInput{
+			RequestItems: requestItems,
+		})
+		if err != nil {
+			return err
+		}
+
+		requestItems = output.UnprocessedItems
+	}
+
+	if len(requestItems) > 0 {
+		return fmt.Errorf("dynamodblocal: %d item(s) still unprocessed after %d attempts", len(requestItems[table]), maxAttempts)
+	}
+
+	return nil
+}
@@ -0,0 +1,60 @@
+package dynamodblocal
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/smithy-go/middleware"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+)
+
+// WithRequestLogging returns a dynamodb client option that dumps every
+// request and response the client makes to w - method, the X-Amz-Target
+// header (e.g. DynamoDB_20120810.PutItem), request JSON, status, and
+// response JSON - which is far more useful than DynamoDB Local's own
+// container logs when debugging a failing SDK call. Pass it to
+// GetDynamoDBClient:
+//
+//	client, err := container.GetDynamoDBClient(ctx, dynamodblocal.WithRequestLogging(os.Stdout))
+func WithRequestLogging(w io.Writer) func(*dynamodb.Options) {
+	return func(o *dynamodb.Options) {
+		o.APIOptions = append(o.APIOptions, func(stack *middleware.Stack) error {
+			return stack.Deserialize.Add(&requestLoggingMiddleware{w: w}, middleware.After)
+		})
+	}
+}
+
+// requestLoggingMiddleware is installed by WithRequestLogging on the
+// Deserialize step, which runs with access to both the outgoing HTTP
+// request and the raw HTTP response.
+type requestLoggingMiddleware struct {
+	w io.Writer
+}
+
+func (m *requestLoggingMiddleware) ID() string { return "dynamodblocal.RequestLogging" }
+
+func (m *requestLoggingMiddleware) HandleDeserialize(ctx context.Context, in middleware.DeserializeInput, next middleware.DeserializeHandler) (middleware.DeserializeOutput, middleware.Metadata, error) {
+	if req, ok := in.Request.(*smithyhttp.Request); ok {
+		var body []byte
+		if stream := req.GetStream(); stream != nil {
+			body, _ = io.ReadAll(stream)
+			if rewound, err := req.SetStream(bytes.NewReader(body)); err == nil {
+				in.Request = rewound
+			}
+		}
+		fmt.Fprintf(m.w, "--> %s %s\n%s\n", req.Method, req.Header.Get("X-Amz-Target"), body)
+	}
+
+	out, metadata, err := next.HandleDeserialize(ctx, in)
+
+	if resp, ok := out.RawResponse.(*smithyhttp.Response); ok {
+		body, _ := io.ReadAll(resp.Body)
+		resp.Body = io.NopCloser(bytes.NewReader(body))
+		fmt.Fprintf(m.w, "<-- %d\n%s\n", resp.StatusCode, body)
+	}
+
+	return out, metadata, err
+}
@@ -0,0 +1,59 @@
+package dynamodblocal
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetDynamoDBClientWithRequestLogging(t *testing.T) {
+	ctx := context.Background()
+
+	container, err := RunContainer(ctx)
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		err := container.Terminate(context.Background())
+		if err != nil {
+			t.Fatalf("container termination failed: %s", err)
+		}
+	})
+
+	var logs bytes.Buffer
+
+	client, err := container.GetDynamoDBClient(ctx, WithRequestLogging(&logs))
+	require.NoError(t, err, "failed to get dynamodb client handle")
+
+	result, err := client.ListTables(ctx, nil)
+	require.NoError(t, err, "dynamodb list tables operation failed")
+	require.Empty(t, result.TableNames)
+
+	require.Contains(t, logs.String(), "DynamoDB_20120810.ListTables")
+}
+
+func TestRoundTripper(t *testing.T) {
+	ctx := context.Background()
+
+	container, err := RunContainer(ctx)
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		err := container.Terminate(context.Background())
+		if err != nil {
+			t.Fatalf("container termination failed: %s", err)
+		}
+	})
+
+	roundTripper, err := container.RoundTripper(ctx)
+	require.NoError(t, err)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "http://ignored", nil)
+	require.NoError(t, err)
+
+	resp, err := roundTripper.RoundTrip(req)
+	require.NoError(t, err, "request should reach the mapped DynamoDB Local port")
+	defer resp.Body.Close()
+}
@@ -0,0 +1,157 @@
+package dynamodblocal
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/docker/go-connections/nat"
+	"github.com/testcontainers/testcontainers-go"
+)
+
+// DynamoDB Local JVM CLI flags accumulated onto req.Cmd by the With* options
+// in this file. buildCmd recognises these when validating the accumulated
+// flags and prepending "-jar DynamoDBLocal.jar".
+const (
+	flagSharedDB                = "-sharedDb"
+	flagInMemory                = "-inMemory"
+	flagDBPath                  = "-dbPath"
+	flagPort                    = "-port"
+	flagCORS                    = "-cors"
+	flagDelayTransientStatuses  = "-delayTransientStatuses"
+	flagOptimizeDbBeforeStartup = "-optimizeDbBeforeStartup"
+	flagDisableTelemetry        = "-disableTelemetry"
+)
+
+// WithSharedDB allows container reuse between successive runs. Data will be persisted
+func WithSharedDB() testcontainers.CustomizeRequestOption {
+
+	return func(req *testcontainers.GenericContainerRequest) {
+		req.Cmd = append(req.Cmd, flagSharedDB)
+		req.Name = containerName
+		req.Reuse = true
+	}
+}
+
+// WithDataVolume mounts hostPath into the container at dataVolumeTargetPath
+// and configures DynamoDB Local (via -dbPath) to persist its database files
+// there, so data survives full container removal - unlike WithSharedDB,
+// which only persists data across container reuse. hostPath is created if
+// it does not already exist, with permissions that allow the dynamodblocal
+// user inside the image to write to it (Docker otherwise creates bind mount
+// directories as root, which DynamoDB Local cannot write to).
+func WithDataVolume(hostPath string) testcontainers.CustomizeRequestOption {
+
+	return func(req *testcontainers.GenericContainerRequest) {
+		if err := os.MkdirAll(hostPath, 0777); err == nil {
+			// MkdirAll does not apply the mode to an already-existing directory
+			os.Chmod(hostPath, 0777)
+		}
+
+		req.Mounts = append(req.Mounts, testcontainers.BindMount(hostPath, testcontainers.ContainerMountTarget(dataVolumeTargetPath)))
+		req.Cmd = append(req.Cmd, flagDBPath, dataVolumeTargetPath)
+	}
+}
+
+// WithTelemetryDisabled - DynamoDB local will not send any telemetry
+func WithTelemetryDisabled() testcontainers.CustomizeRequestOption {
+
+	return func(req *testcontainers.GenericContainerRequest) {
+		req.Cmd = append(req.Cmd, flagDisableTelemetry)
+	}
+}
+
+// WithInMemory runs DynamoDB Local against an in-memory database instead of
+// writing to disk. Mutually exclusive with WithDataVolume.
+func WithInMemory() testcontainers.CustomizeRequestOption {
+
+	return func(req *testcontainers.GenericContainerRequest) {
+		req.Cmd = append(req.Cmd, flagInMemory)
+	}
+}
+
+// WithPort makes DynamoDB Local listen on a port other than its default of
+// 8000 inside the container. RunContainer reads the port back out of the
+// accumulated flags to expose and wait on it.
+func WithPort(p int) testcontainers.CustomizeRequestOption {
+
+	return func(req *testcontainers.GenericContainerRequest) {
+		req.Cmd = append(req.Cmd, flagPort, strconv.Itoa(p))
+	}
+}
+
+// WithCORS sets the comma-separated list of allowed CORS origins. Pass "*"
+// to allow all origins.
+func WithCORS(origins ...string) testcontainers.CustomizeRequestOption {
+
+	return func(req *testcontainers.GenericContainerRequest) {
+		req.Cmd = append(req.Cmd, flagCORS, strings.Join(origins, ","))
+	}
+}
+
+// WithDelayTransientStatuses adds delays for some operations, so that
+// tables/indexes stay in a transient state (CREATING, DELETING, UPDATING)
+// for a bit, mimicking production DynamoDB more closely.
+func WithDelayTransientStatuses() testcontainers.CustomizeRequestOption {
+
+	return func(req *testcontainers.GenericContainerRequest) {
+		req.Cmd = append(req.Cmd, flagDelayTransientStatuses)
+	}
+}
+
+// WithOptimizeDbBeforeStartup optimizes the underlying database table file
+// before DynamoDB Local starts. Must be combined with WithDataVolume, since
+// there is nothing to optimize against an in-memory (or fresh) database.
+func WithOptimizeDbBeforeStartup() testcontainers.CustomizeRequestOption {
+
+	return func(req *testcontainers.GenericContainerRequest) {
+		req.Cmd = append(req.Cmd, flagOptimizeDbBeforeStartup)
+	}
+}
+
+// buildCmd runs once, after every CustomizeRequestOption has been applied
+// and right before the container starts. It validates the DynamoDB Local
+// flags accumulated onto req.Cmd by the With* options above - so mutually
+// exclusive combinations (e.g. WithInMemory and WithDataVolume) fail with a
+// clear error rather than a mystery container-start failure - and prepends
+// "-jar DynamoDBLocal.jar" exactly once, so options can be passed to
+// RunContainer in any order without one clobbering another's flags.
+func buildCmd(req *testcontainers.GenericContainerRequest) error {
+	if len(req.Cmd) == 0 {
+		return nil
+	}
+
+	hasFlag := func(flag string) bool {
+		for _, c := range req.Cmd {
+			if c == flag {
+				return true
+			}
+		}
+		return false
+	}
+
+	if hasFlag(flagInMemory) && hasFlag(flagDBPath) {
+		return fmt.Errorf("dynamodblocal: WithInMemory and WithDataVolume cannot be combined (-inMemory and -dbPath are mutually exclusive)")
+	}
+
+	if hasFlag(flagOptimizeDbBeforeStartup) && !hasFlag(flagDBPath) {
+		return fmt.Errorf("dynamodblocal: WithOptimizeDbBeforeStartup requires WithDataVolume (-optimizeDbBeforeStartup has no effect without -dbPath)")
+	}
+
+	req.Cmd = append([]string{"-jar", "DynamoDBLocal.jar"}, req.Cmd...)
+	return nil
+}
+
+// portFromCmd returns the DynamoDB Local listening port requested via
+// WithPort, found by reading the flag back out of req.Cmd, or the default
+// port if WithPort wasn't used.
+func portFromCmd(cmd []string) nat.Port {
+	for i, c := range cmd {
+		if c == flagPort && i+1 < len(cmd) {
+			return nat.Port(cmd[i+1] + "/tcp")
+		}
+	}
+
+	return port
+}
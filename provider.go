@@ -0,0 +1,143 @@
+package dynamodblocal
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	smithymiddleware "github.com/aws/smithy-go/middleware"
+	"github.com/testcontainers/testcontainers-go"
+)
+
+// Provider abstracts over where the DynamoDB a test talks to comes from, so
+// the same test code can run against DynamoDB Local or a real AWS account.
+// Client returns a ready-to-use client and a cleanup func that must be
+// called once the test is done with it.
+type Provider interface {
+	Client(ctx context.Context) (*dynamodb.Client, func(), error)
+}
+
+// LocalProvider is a Provider backed by a DynamoDB Local container, started
+// via RunContainer with Opts. The cleanup func terminates the container.
+type LocalProvider struct {
+	Opts []testcontainers.ContainerCustomizer
+}
+
+func (p LocalProvider) Client(ctx context.Context) (*dynamodb.Client, func(), error) {
+	container, err := RunContainer(ctx, p.Opts...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	client, err := container.GetDynamoDBClient(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	cleanup := func() {
+		_ = container.Terminate(context.Background())
+	}
+
+	return client, cleanup, nil
+}
+
+// AWSProvider is a Provider backed by a real DynamoDB endpoint, reached
+// using the default AWS credential chain. TablePrefix is required - it is
+// not used for matching existing tables, only to make intent explicit and
+// catch copy-paste mistakes - and the returned client tracks every table it
+// creates so the cleanup func can drop exactly those tables, without ever
+// touching anything else in the account.
+type AWSProvider struct {
+	Region      string
+	TablePrefix string
+}
+
+func (p AWSProvider) Client(ctx context.Context) (*dynamodb.Client, func(), error) {
+	if p.TablePrefix == "" {
+		return nil, nil, fmt.Errorf("dynamodblocal: AWSProvider.TablePrefix must be set - cleanup only drops tables this run created, and refuses to guess at the account's other tables")
+	}
+
+	cfg, err := config.LoadDefaultConfig(ctx, config.WithRegion(p.Region))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tracker := &createdTableTracker{}
+
+	client := dynamodb.NewFromConfig(cfg, func(o *dynamodb.Options) {
+		o.APIOptions = append(o.APIOptions, tracker.registerWith)
+	})
+
+	cleanup := func() {
+		tracker.deleteAll(context.Background(), client)
+	}
+
+	return client, cleanup, nil
+}
+
+// createdTableTracker records the name of every table a successful
+// CreateTable call on a client created, so cleanup can delete exactly those
+// tables instead of pattern-matching every table in the account.
+type createdTableTracker struct {
+	mu     sync.Mutex
+	tables []string
+}
+
+func (t *createdTableTracker) registerWith(stack *smithymiddleware.Stack) error {
+	return stack.Initialize.Add(smithymiddleware.InitializeMiddlewareFunc(
+		"dynamodblocal.TrackCreatedTables",
+		func(ctx context.Context, in smithymiddleware.InitializeInput, next smithymiddleware.InitializeHandler) (smithymiddleware.InitializeOutput, smithymiddleware.Metadata, error) {
+			out, metadata, err := next.HandleInitialize(ctx, in)
+			if input, ok := in.Parameters.(*dynamodb.CreateTableInput); ok && input.TableName != nil && err == nil {
+				t.mu.Lock()
+				t.tables = append(t.tables, *input.TableName)
+				t.mu.Unlock()
+			}
+			return out, metadata, err
+		},
+	), smithymiddleware.After)
+}
+
+func (t *createdTableTracker) deleteAll(ctx context.Context, client *dynamodb.Client) {
+	t.mu.Lock()
+	tables := append([]string(nil), t.tables...)
+	t.mu.Unlock()
+
+	for _, tableName := range tables {
+		_, _ = client.DeleteTable(ctx, &dynamodb.DeleteTableInput{TableName: aws.String(tableName)})
+	}
+}
+
+// DynamoDBTestModeEnvVar is the environment variable NewProviderFromEnv
+// reads to decide which Provider to return.
+const DynamoDBTestModeEnvVar = "DYNAMODB_TEST_MODE"
+
+// NewProviderFromEnv returns an AWSProvider when DYNAMODB_TEST_MODE=aws, and
+// a LocalProvider otherwise (including when the variable is unset), so CI
+// can flip a single env var to promote a suite from a local DynamoDB Local
+// run to a real AWS integration run without changing any test code. opts
+// are forwarded to RunContainer when a LocalProvider is returned.
+func NewProviderFromEnv(opts ...testcontainers.ContainerCustomizer) (Provider, error) {
+	switch mode := os.Getenv(DynamoDBTestModeEnvVar); mode {
+	case "", "local":
+		return LocalProvider{Opts: opts}, nil
+	case "aws":
+		region := os.Getenv("AWS_REGION")
+		if region == "" {
+			return nil, fmt.Errorf("dynamodblocal: AWS_REGION must be set when %s=aws", DynamoDBTestModeEnvVar)
+		}
+
+		tablePrefix := os.Getenv("DYNAMODB_TEST_TABLE_PREFIX")
+		if tablePrefix == "" {
+			return nil, fmt.Errorf("dynamodblocal: DYNAMODB_TEST_TABLE_PREFIX must be set when %s=aws", DynamoDBTestModeEnvVar)
+		}
+
+		return AWSProvider{Region: region, TablePrefix: tablePrefix}, nil
+	default:
+		return nil, fmt.Errorf("dynamodblocal: unknown %s %q (want \"local\" or \"aws\")", DynamoDBTestModeEnvVar, mode)
+	}
+}
@@ -0,0 +1,66 @@
+package dynamodblocal
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocalProviderClient(t *testing.T) {
+	ctx := context.Background()
+
+	provider, err := NewProviderFromEnv()
+	require.NoError(t, err)
+	require.IsType(t, LocalProvider{}, provider)
+
+	client, cleanup, err := provider.Client(ctx)
+	require.NoError(t, err)
+	t.Cleanup(cleanup)
+
+	err = createTable(client)
+	require.NoError(t, err, "dynamodb create table operation failed")
+}
+
+func TestNewProviderFromEnvReturnsAWSProvider(t *testing.T) {
+	t.Setenv(DynamoDBTestModeEnvVar, "aws")
+	t.Setenv("AWS_REGION", "us-east-1")
+	t.Setenv("DYNAMODB_TEST_TABLE_PREFIX", "myapp_test_")
+
+	provider, err := NewProviderFromEnv()
+	require.NoError(t, err)
+	require.Equal(t, AWSProvider{Region: "us-east-1", TablePrefix: "myapp_test_"}, provider)
+}
+
+func TestNewProviderFromEnvRequiresRegionForAWS(t *testing.T) {
+	t.Setenv(DynamoDBTestModeEnvVar, "aws")
+	t.Setenv("DYNAMODB_TEST_TABLE_PREFIX", "myapp_test_")
+	os.Unsetenv("AWS_REGION")
+
+	_, err := NewProviderFromEnv()
+	require.Error(t, err)
+}
+
+func TestNewProviderFromEnvRequiresTablePrefixForAWS(t *testing.T) {
+	t.Setenv(DynamoDBTestModeEnvVar, "aws")
+	t.Setenv("AWS_REGION", "us-east-1")
+	os.Unsetenv("DYNAMODB_TEST_TABLE_PREFIX")
+
+	_, err := NewProviderFromEnv()
+	require.Error(t, err)
+}
+
+func TestNewProviderFromEnvRejectsUnknownMode(t *testing.T) {
+	t.Setenv(DynamoDBTestModeEnvVar, "bogus")
+
+	_, err := NewProviderFromEnv()
+	require.Error(t, err)
+}
+
+func TestAWSProviderClientRequiresTablePrefix(t *testing.T) {
+	ctx := context.Background()
+
+	_, _, err := AWSProvider{Region: "us-east-1"}.Client(ctx)
+	require.Error(t, err, "AWSProvider.Client should refuse to run cleanup against an unscoped account")
+}
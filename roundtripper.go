@@ -0,0 +1,42 @@
+package dynamodblocal
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+)
+
+// RoundTripper returns an http.RoundTripper pointed at this container's
+// mapped DynamoDB Local port, so clients other than the v2 client
+// GetDynamoDBClient returns - e.g. github.com/guregu/dynamo, or the v1 AWS
+// SDK - can be pointed at the running container without going through this
+// module's client factory.
+func (c *DynamodbLocalContainer) RoundTripper(ctx context.Context) (http.RoundTripper, error) {
+	hostAndPort, err := c.ConnectionString(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &endpointRoundTripper{endpoint: "http://" + hostAndPort}, nil
+}
+
+// endpointRoundTripper rewrites every request's scheme and host to point at
+// endpoint before delegating to http.DefaultTransport, so callers can build
+// a client against DynamoDB Local without knowing its mapped port up front.
+type endpointRoundTripper struct {
+	endpoint string
+}
+
+func (rt *endpointRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	target, err := url.Parse(rt.endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.Clone(req.Context())
+	req.URL.Scheme = target.Scheme
+	req.URL.Host = target.Host
+	req.Host = target.Host
+
+	return http.DefaultTransport.RoundTrip(req)
+}
@@ -0,0 +1,86 @@
+package dynamodblocal
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/testcontainers/testcontainers-go"
+)
+
+var (
+	sharedInstance     *DynamodbLocalContainer
+	sharedInstanceErr  error
+	sharedInstanceOnce sync.Once
+)
+
+// SharedInstance returns a process-wide singleton DynamoDB Local container,
+// starting it (with opts) on the first call and handing the same instance
+// to every subsequent caller. It amortizes the cost of starting a container
+// across a whole test suite; pair it with Reset to give each test a clean
+// slate, or with Main for ready-made TestMain wiring.
+func SharedInstance(ctx context.Context, opts ...testcontainers.ContainerCustomizer) (*DynamodbLocalContainer, error) {
+	sharedInstanceOnce.Do(func() {
+		sharedInstance, sharedInstanceErr = RunContainer(ctx, opts...)
+	})
+
+	return sharedInstance, sharedInstanceErr
+}
+
+// Reset gives the container a clean slate by listing every table via
+// ListTables and deleting it, so a container obtained from SharedInstance
+// can be reused across tests without paying full container-start cost
+// between each one.
+func (c *DynamodbLocalContainer) Reset(ctx context.Context) error {
+	client, err := c.GetDynamoDBClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	var tableNames []string
+	paginator := dynamodb.NewListTablesPaginator(client, &dynamodb.ListTablesInput{})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return fmt.Errorf("dynamodblocal: failed to list tables: %w", err)
+		}
+		tableNames = append(tableNames, page.TableNames...)
+	}
+
+	for _, tableName := range tableNames {
+		if _, err := client.DeleteTable(ctx, &dynamodb.DeleteTableInput{TableName: aws.String(tableName)}); err != nil {
+			return fmt.Errorf("dynamodblocal: failed to delete table %q: %w", tableName, err)
+		}
+	}
+
+	return nil
+}
+
+// Main starts a SharedInstance, runs the test suite via m.Run(), then
+// terminates the container and exits with the suite's exit code. Drop it
+// into a TestMain to start DynamoDB Local once for the whole package:
+//
+//	func TestMain(m *testing.M) {
+//		dynamodblocal.Main(m)
+//	}
+func Main(m *testing.M, opts ...testcontainers.ContainerCustomizer) {
+	ctx := context.Background()
+
+	container, err := SharedInstance(ctx, opts...)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "dynamodblocal: failed to start shared container: %s\n", err)
+		os.Exit(1)
+	}
+
+	code := m.Run()
+
+	if err := container.Terminate(ctx); err != nil {
+		fmt.Fprintf(os.Stderr, "dynamodblocal: failed to terminate shared container: %s\n", err)
+	}
+
+	os.Exit(code)
+}
@@ -0,0 +1,39 @@
+package dynamodblocal
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSharedInstanceReturnsSameContainerAndResetClearsTables(t *testing.T) {
+	ctx := context.Background()
+
+	container, err := SharedInstance(ctx)
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		err := container.Terminate(context.Background())
+		if err != nil {
+			t.Fatalf("container termination failed: %s", err)
+		}
+	})
+
+	again, err := SharedInstance(ctx)
+	require.NoError(t, err)
+	require.Same(t, container, again, "SharedInstance should return the same container on every call")
+
+	client, err := container.GetDynamoDBClient(ctx)
+	require.NoError(t, err, "failed to get dynamodb client handle")
+
+	err = createTable(client)
+	require.NoError(t, err, "dynamodb create table operation failed")
+
+	err = container.Reset(ctx)
+	require.NoError(t, err, "reset should succeed")
+
+	result, err := client.ListTables(ctx, nil)
+	require.NoError(t, err, "dynamodb list tables operation failed")
+	require.Empty(t, result.TableNames, "reset should have dropped every table")
+}